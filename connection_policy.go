@@ -0,0 +1,82 @@
+// Copyright (c) quickfixengine.org  All rights reserved.
+//
+// This file may be distributed under the terms of the quickfixengine.org
+// license as defined by quickfixengine.org and appearing in the file
+// LICENSE included in the packaging of this file.
+//
+// This file is provided AS IS with NO WARRANTY OF ANY KIND, INCLUDING
+// THE WARRANTY OF DESIGN, MERCHANTABILITY AND FITNESS FOR A
+// PARTICULAR PURPOSE.
+//
+// See http://www.quickfixengine.org/LICENSE for licensing information.
+//
+// Contact ask@quickfixengine.org if any conditions of this licensing
+// are not clear to you.
+
+package quickfix
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"time"
+)
+
+// ConnectionAttemptPolicy drives the initiator's dial loop for a session. It decides
+// where to dial and for how long on each attempt, and whether/when to retry after the
+// attempt's result is known. Implementations can rotate through failover endpoints,
+// consult a service-discovery resolver, or otherwise customize HA reconnect behavior.
+type ConnectionAttemptPolicy interface {
+	// NextEndpoint returns the address to dial and the per-attempt dial timeout (zero
+	// means no deadline) for the given connection attempt.
+	NextEndpoint(ctx context.Context, session *session, attempt int) (addr string, timeout time.Duration, err error)
+
+	// OnResult is called after a connection attempt ends, whether it failed to dial,
+	// failed to start the session, or disconnected after staying connected for
+	// connectedFor (measured from session start, before logon completes). It returns
+	// whether to retry, the attempt number to use for the next call (letting a policy
+	// reset backoff after a connection that proved itself healthy), and the delay to
+	// wait before that next attempt.
+	OnResult(session *session, attempt int, err error, connectedFor time.Duration) (retry bool, nextAttempt int, delay time.Duration)
+}
+
+// AddressableDialer is implemented by Dialers that can dial an address supplied by a
+// ConnectionAttemptPolicy instead of deriving it from SocketConnectHost/Port themselves.
+type AddressableDialer interface {
+	Dialer
+	DialAddr(ctx context.Context, addr string, session *session, connectionAttempt int, tlsConfig *tls.Config) (net.Conn, error)
+}
+
+// defaultConnectionAttemptPolicy reproduces the initiator's built-in behavior: dial
+// SocketConnectHost/SocketConnectPort with a SocketConnectTimeout deadline, and retry
+// with the exponential backoff and jitter configured on the session.
+type defaultConnectionAttemptPolicy struct {
+	cfg retryConfig
+}
+
+func (d defaultConnectionAttemptPolicy) NextEndpoint(ctx context.Context, session *session, attempt int) (string, time.Duration, error) {
+	return defaultSocketConnectAddr(session), d.cfg.connectTimeout, nil
+}
+
+// defaultSocketConnectAddr formats the address the initiator dials when a
+// ConnectionAttemptPolicy doesn't resolve one of its own: SocketConnectHost:SocketConnectPort.
+func defaultSocketConnectAddr(session *session) string {
+	return fmt.Sprintf("%v:%v", session.SocketConnectHost, session.SocketConnectPort)
+}
+
+func (d defaultConnectionAttemptPolicy) OnResult(session *session, attempt int, err error, connectedFor time.Duration) (bool, int, time.Duration) {
+	// A connection that actually came up and stayed up at least resetInterval is
+	// considered healthy; reset the backoff so a later blip starts from the base
+	// interval again instead of compounding off a long-lived session's final attempt
+	// count. connectedFor is the zero value for an attempt that never connected at
+	// all, so guard on it explicitly rather than letting a zero resetInterval make
+	// every failed attempt look "healthy."
+	if connectedFor > 0 && connectedFor >= d.cfg.resetInterval {
+		attempt = 0
+	}
+
+	delay := nextReconnectInterval(session.ReconnectInterval, attempt, d.cfg)
+
+	return true, attempt + 1, delay
+}
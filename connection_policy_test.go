@@ -0,0 +1,81 @@
+// Copyright (c) quickfixengine.org  All rights reserved.
+//
+// This file may be distributed under the terms of the quickfixengine.org
+// license as defined by quickfixengine.org and appearing in the file
+// LICENSE included in the packaging of this file.
+//
+// This file is provided AS IS with NO WARRANTY OF ANY KIND, INCLUDING
+// THE WARRANTY OF DESIGN, MERCHANTABILITY AND FITNESS FOR A
+// PARTICULAR PURPOSE.
+//
+// See http://www.quickfixengine.org/LICENSE for licensing information.
+//
+// Contact ask@quickfixengine.org if any conditions of this licensing
+// are not clear to you.
+
+package quickfix
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestDefaultConnectionAttemptPolicyOnResultAdvancesAttempt(t *testing.T) {
+	policy := defaultConnectionAttemptPolicy{cfg: retryConfig{backoffMultiplier: 2}}
+	sess := &session{ReconnectInterval: time.Second}
+
+	retry, nextAttempt, _ := policy.OnResult(sess, 2, nil, 0)
+	if !retry {
+		t.Fatal("expected retry=true")
+	}
+	if nextAttempt != 3 {
+		t.Errorf("got nextAttempt=%d, want 3", nextAttempt)
+	}
+}
+
+func TestDefaultConnectionAttemptPolicyOnResultResetsAfterHealthyConnection(t *testing.T) {
+	policy := defaultConnectionAttemptPolicy{
+		cfg: retryConfig{backoffMultiplier: 2, resetInterval: time.Minute},
+	}
+	sess := &session{ReconnectInterval: time.Second}
+
+	retry, nextAttempt, delay := policy.OnResult(sess, 5, nil, 2*time.Minute)
+	if !retry {
+		t.Fatal("expected retry=true")
+	}
+	if nextAttempt != 1 {
+		t.Errorf("got nextAttempt=%d, want 1 (reset to 0, then advanced)", nextAttempt)
+	}
+	if delay != time.Second {
+		t.Errorf("got delay=%v, want base interval %v after reset", delay, time.Second)
+	}
+}
+
+func TestDefaultConnectionAttemptPolicyNextEndpointUsesSocketConnectHostPort(t *testing.T) {
+	policy := defaultConnectionAttemptPolicy{}
+	sess := &session{SocketConnectHost: "gateway.example.com", SocketConnectPort: 5001}
+
+	addr, _, err := policy.NextEndpoint(context.Background(), sess, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := "gateway.example.com:5001"; addr != want {
+		t.Errorf("got addr=%q, want %q", addr, want)
+	}
+}
+
+func TestDefaultConnectionAttemptPolicyOnResultKeepsBackoffWhenShortLived(t *testing.T) {
+	policy := defaultConnectionAttemptPolicy{
+		cfg: retryConfig{backoffMultiplier: 2, resetInterval: time.Minute},
+	}
+	sess := &session{ReconnectInterval: time.Second}
+
+	_, nextAttempt, delay := policy.OnResult(sess, 5, nil, time.Millisecond)
+	if nextAttempt != 6 {
+		t.Errorf("got nextAttempt=%d, want 6 (not reset)", nextAttempt)
+	}
+	if delay <= time.Second {
+		t.Errorf("got delay=%v, want backed-off delay greater than base interval", delay)
+	}
+}
@@ -0,0 +1,191 @@
+// Copyright (c) quickfixengine.org  All rights reserved.
+//
+// This file may be distributed under the terms of the quickfixengine.org
+// license as defined by quickfixengine.org and appearing in the file
+// LICENSE included in the packaging of this file.
+//
+// This file is provided AS IS with NO WARRANTY OF ANY KIND, INCLUDING
+// THE WARRANTY OF DESIGN, MERCHANTABILITY AND FITNESS FOR A
+// PARTICULAR PURPOSE.
+//
+// See http://www.quickfixengine.org/LICENSE for licensing information.
+//
+// Contact ask@quickfixengine.org if any conditions of this licensing
+// are not clear to you.
+
+package quickfix
+
+import (
+	"context"
+	"sync"
+)
+
+// State describes the connectivity of an initiator session, mirroring the states a
+// caller needs to gate behavior (such as order submission) on a real logged-on
+// connection rather than polling IsLoggedOn.
+type State int
+
+const (
+	// Idle means the session is not attempting to connect: it is waiting for session
+	// time, or about to start its next connection attempt.
+	Idle State = iota
+	// Connecting means a dial is in progress.
+	Connecting
+	// Connected means the TCP/transport connection is up but logon has not completed.
+	Connected
+	// LoggedOn means the FIX session has completed logon.
+	LoggedOn
+	// TransientFailure means the last connection attempt failed or the connection
+	// dropped, and a reconnect will be attempted.
+	TransientFailure
+	// Shutdown means the session will not reconnect: the initiator has stopped, or its
+	// ConnectionAttemptPolicy declined to retry.
+	Shutdown
+)
+
+func (s State) String() string {
+	switch s {
+	case Idle:
+		return "IDLE"
+	case Connecting:
+		return "CONNECTING"
+	case Connected:
+		return "CONNECTED"
+	case LoggedOn:
+		return "LOGGED_ON"
+	case TransientFailure:
+		return "TRANSIENT_FAILURE"
+	case Shutdown:
+		return "SHUTDOWN"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// StateChangeApplication is implemented optionally by an Application to be notified of
+// initiator connectivity transitions. Applications that don't implement it compile and
+// run unchanged.
+type StateChangeApplication interface {
+	OnStateChange(sessionID SessionID, state State)
+}
+
+// connState tracks the current State for a single session and lets callers block until
+// it changes, the same pattern gRPC uses for connectivity.State.
+type connState struct {
+	mu      sync.Mutex
+	current State
+	changed chan interface{}
+}
+
+func newConnState() *connState {
+	return &connState{changed: make(chan interface{})}
+}
+
+func (s *connState) set(state State) (old, new State) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	old = s.current
+	if old == state {
+		return old, old
+	}
+
+	s.current = state
+	close(s.changed)
+	s.changed = make(chan interface{})
+
+	return old, state
+}
+
+func (s *connState) get() (State, chan interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.current, s.changed
+}
+
+// connState looks up the tracked connState for sessionID under the Initiator's map
+// lock; the returned *connState has its own locking for the current/changed fields.
+func (i *Initiator) connState(sessionID SessionID) (*connState, bool) {
+	i.connMu.RLock()
+	defer i.connMu.RUnlock()
+
+	cs, ok := i.connStates[sessionID]
+	return cs, ok
+}
+
+// GetState returns the current connectivity State of sessionID, and false if sessionID
+// is not managed by this Initiator.
+func (i *Initiator) GetState(sessionID SessionID) (State, bool) {
+	cs, ok := i.connState(sessionID)
+	if !ok {
+		return Idle, false
+	}
+
+	state, _ := cs.get()
+	return state, true
+}
+
+// WaitForStateChange blocks until sessionID's State differs from current, ctx is done,
+// or the Initiator stops, whichever happens first. It returns the new state and true,
+// the last known state and false if ctx was done or the Initiator stopped, or Idle and
+// false if sessionID is unknown.
+func (i *Initiator) WaitForStateChange(ctx context.Context, sessionID SessionID, current State) (State, bool) {
+	cs, ok := i.connState(sessionID)
+	if !ok {
+		return Idle, false
+	}
+
+	state, changed := cs.get()
+	if state != current {
+		return state, true
+	}
+
+	select {
+	case <-changed:
+		state, _ = cs.get()
+		return state, true
+	case <-ctx.Done():
+		return state, false
+	case <-i.stopChan:
+		state, _ = cs.get()
+		return state, false
+	}
+}
+
+// setState transitions sessionID to state and, if it actually changed, notifies any
+// WaitForStateChange callers and the Application's optional StateChangeApplication hook.
+func (i *Initiator) setState(sessionID SessionID, state State) {
+	cs, ok := i.connState(sessionID)
+	if !ok {
+		return
+	}
+
+	old, new := cs.set(state)
+	if old == new {
+		return
+	}
+
+	if sc, ok := i.app.(StateChangeApplication); ok {
+		sc.OnStateChange(sessionID, new)
+	}
+}
+
+// stateTrackingApplication wraps the user-supplied Application so that initiator
+// session state is kept in sync with logon/logout notifications, without requiring any
+// change to Application implementations.
+type stateTrackingApplication struct {
+	Application
+	sessionID SessionID
+	initiator *Initiator
+}
+
+func (a *stateTrackingApplication) OnLogon(sessionID SessionID) {
+	a.initiator.setState(sessionID, LoggedOn)
+	a.Application.OnLogon(sessionID)
+}
+
+func (a *stateTrackingApplication) OnLogout(sessionID SessionID) {
+	a.initiator.setState(sessionID, Connected)
+	a.Application.OnLogout(sessionID)
+}
@@ -0,0 +1,109 @@
+// Copyright (c) quickfixengine.org  All rights reserved.
+//
+// This file may be distributed under the terms of the quickfixengine.org
+// license as defined by quickfixengine.org and appearing in the file
+// LICENSE included in the packaging of this file.
+//
+// This file is provided AS IS with NO WARRANTY OF ANY KIND, INCLUDING
+// THE WARRANTY OF DESIGN, MERCHANTABILITY AND FITNESS FOR A
+// PARTICULAR PURPOSE.
+//
+// See http://www.quickfixengine.org/LICENSE for licensing information.
+//
+// Contact ask@quickfixengine.org if any conditions of this licensing
+// are not clear to you.
+
+package quickfix
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func newTestInitiator(sessionID SessionID) *Initiator {
+	return &Initiator{
+		connStates: map[SessionID]*connState{sessionID: newConnState()},
+		stopChan:   make(chan interface{}),
+	}
+}
+
+func TestGetStateUnknownSession(t *testing.T) {
+	i := newTestInitiator(SessionID{SenderCompID: "MANAGED"})
+
+	if _, ok := i.GetState(SessionID{SenderCompID: "UNMANAGED"}); ok {
+		t.Error("expected ok=false for an unmanaged session")
+	}
+}
+
+func TestGetStateDefaultsToIdle(t *testing.T) {
+	sessionID := SessionID{}
+	i := newTestInitiator(sessionID)
+
+	state, ok := i.GetState(sessionID)
+	if !ok {
+		t.Fatal("expected ok=true for a managed session")
+	}
+	if state != Idle {
+		t.Errorf("got %v, want %v", state, Idle)
+	}
+}
+
+func TestSetStateUpdatesCurrentState(t *testing.T) {
+	sessionID := SessionID{}
+	i := newTestInitiator(sessionID)
+
+	i.setState(sessionID, Connecting)
+
+	state, ok := i.GetState(sessionID)
+	if !ok || state != Connecting {
+		t.Fatalf("got (%v, %v), want (%v, true)", state, ok, Connecting)
+	}
+}
+
+func TestWaitForStateChangeReturnsImmediatelyWhenAlreadyChanged(t *testing.T) {
+	sessionID := SessionID{}
+	i := newTestInitiator(sessionID)
+	i.setState(sessionID, Connected)
+
+	state, ok := i.WaitForStateChange(context.Background(), sessionID, Idle)
+	if !ok || state != Connected {
+		t.Fatalf("got (%v, %v), want (%v, true)", state, ok, Connected)
+	}
+}
+
+func TestWaitForStateChangeBlocksUntilChanged(t *testing.T) {
+	sessionID := SessionID{}
+	i := newTestInitiator(sessionID)
+
+	done := make(chan State, 1)
+	go func() {
+		state, _ := i.WaitForStateChange(context.Background(), sessionID, Idle)
+		done <- state
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	i.setState(sessionID, LoggedOn)
+
+	select {
+	case state := <-done:
+		if state != LoggedOn {
+			t.Errorf("got %v, want %v", state, LoggedOn)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("WaitForStateChange did not return after state change")
+	}
+}
+
+func TestWaitForStateChangeReturnsFalseOnContextDone(t *testing.T) {
+	sessionID := SessionID{}
+	i := newTestInitiator(sessionID)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, ok := i.WaitForStateChange(ctx, sessionID, Idle)
+	if ok {
+		t.Error("expected ok=false once ctx is done")
+	}
+}
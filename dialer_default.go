@@ -0,0 +1,28 @@
+// Copyright (c) quickfixengine.org  All rights reserved.
+//
+// This file may be distributed under the terms of the quickfixengine.org
+// license as defined by quickfixengine.org and appearing in the file
+// LICENSE included in the packaging of this file.
+//
+// This file is provided AS IS with NO WARRANTY OF ANY KIND, INCLUDING
+// THE WARRANTY OF DESIGN, MERCHANTABILITY AND FITNESS FOR A
+// PARTICULAR PURPOSE.
+//
+// See http://www.quickfixengine.org/LICENSE for licensing information.
+//
+// Contact ask@quickfixengine.org if any conditions of this licensing
+// are not clear to you.
+
+// Build without the quickfix_quic tag: dialer_quic.go (and its unresolved
+// github.com/quic-go/quic-go import) is excluded, so selectDialer has no QUIC dialer to
+// switch to.
+//go:build !quickfix_quic
+
+package quickfix
+
+// selectDialer returns base unchanged. QUIC transport support (SocketTransport=quic)
+// requires building with the quickfix_quic tag once this tree has a go.mod/go.sum that
+// resolves github.com/quic-go/quic-go.
+func selectDialer(settings *SessionSettings, base Dialer) Dialer {
+	return base
+}
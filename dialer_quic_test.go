@@ -0,0 +1,73 @@
+// Copyright (c) quickfixengine.org  All rights reserved.
+//
+// This file may be distributed under the terms of the quickfixengine.org
+// license as defined by quickfixengine.org and appearing in the file
+// LICENSE included in the packaging of this file.
+//
+// This file is provided AS IS with NO WARRANTY OF ANY KIND, INCLUDING
+// THE WARRANTY OF DESIGN, MERCHANTABILITY AND FITNESS FOR A
+// PARTICULAR PURPOSE.
+//
+// See http://www.quickfixengine.org/LICENSE for licensing information.
+//
+// Contact ask@quickfixengine.org if any conditions of this licensing
+// are not clear to you.
+
+// Exercises dialer_quic.go, so it only builds where that file does; see its build-tag
+// note.
+//go:build quickfix_quic
+
+package quickfix
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"testing"
+)
+
+// quicDialer must satisfy AddressableDialer so a ConnectionAttemptPolicy can hand it an
+// address resolved from something other than SocketConnectHost/Port.
+var _ AddressableDialer = quicDialer{}
+
+// fakeDialer stands in for the TCP+TLS dialer loadDialerConfig would otherwise return,
+// so selectDialer's branching can be asserted without a real connection.
+type fakeDialer struct{}
+
+func (fakeDialer) Dial(ctx context.Context, session *session, connectionAttempt int, tlsConfig *tls.Config) (net.Conn, error) {
+	return nil, nil
+}
+
+func TestSelectDialerSwitchesToQUICWhenSocketTransportIsQUIC(t *testing.T) {
+	settings := NewSessionSettings()
+	settings.Set(configSocketTransport, socketTransportQUIC)
+
+	dialer := selectDialer(settings, fakeDialer{})
+
+	if _, ok := dialer.(quicDialer); !ok {
+		t.Fatalf("got %T, want quicDialer", dialer)
+	}
+}
+
+func TestSelectDialerKeepsBaseDialerWhenSocketTransportUnset(t *testing.T) {
+	settings := NewSessionSettings()
+	base := fakeDialer{}
+
+	dialer := selectDialer(settings, base)
+
+	if _, ok := dialer.(fakeDialer); !ok {
+		t.Fatalf("got %T, want fakeDialer (base dialer unchanged)", dialer)
+	}
+}
+
+func TestSelectDialerKeepsBaseDialerWhenSocketTransportIsSomethingElse(t *testing.T) {
+	settings := NewSessionSettings()
+	settings.Set(configSocketTransport, "tcp")
+	base := fakeDialer{}
+
+	dialer := selectDialer(settings, base)
+
+	if _, ok := dialer.(fakeDialer); !ok {
+		t.Fatalf("got %T, want fakeDialer (base dialer unchanged)", dialer)
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"crypto/tls"
 	"fmt"
+	"net"
 	"sync"
 	"time"
 )
@@ -35,6 +36,9 @@ type Initiator struct {
 	stopChan        chan interface{}
 	wg              sync.WaitGroup
 	sessions        map[SessionID]*session
+	connMu          sync.RWMutex
+	connStates      map[SessionID]*connState
+	connPolicies    map[SessionID]ConnectionAttemptPolicy
 	sessionFactory
 }
 
@@ -56,6 +60,7 @@ func (i *Initiator) Start() (err error) {
 		if dialer, err = loadDialerConfig(settings); err != nil {
 			return err
 		}
+		dialer = selectDialer(settings, dialer)
 
 		fmt.Println("handle connections")
 
@@ -97,6 +102,8 @@ func NewInitiator(app Application, storeFactory MessageStoreFactory, appSettings
 		sessionSettings: appSettings.SessionSettings(),
 		logFactory:      logFactory,
 		sessions:        make(map[SessionID]*session),
+		connStates:      make(map[SessionID]*connState),
+		connPolicies:    make(map[SessionID]ConnectionAttemptPolicy),
 		sessionFactory:  sessionFactory{true},
 	}
 
@@ -107,7 +114,11 @@ func NewInitiator(app Application, storeFactory MessageStoreFactory, appSettings
 	}
 
 	for sessionID, s := range i.sessionSettings {
-		session, err := i.createSession(sessionID, storeFactory, s, logFactory, app)
+		i.connStates[sessionID] = newConnState()
+		i.connPolicies[sessionID] = defaultConnectionAttemptPolicy{cfg: loadRetryConfig(s)}
+
+		wrappedApp := &stateTrackingApplication{Application: app, sessionID: sessionID, initiator: i}
+		session, err := i.createSession(sessionID, storeFactory, s, logFactory, wrappedApp)
 		if err != nil {
 			return nil, err
 		}
@@ -118,6 +129,29 @@ func NewInitiator(app Application, storeFactory MessageStoreFactory, appSettings
 	return i, nil
 }
 
+// SetConnectionAttemptPolicy overrides the ConnectionAttemptPolicy used to drive dial
+// attempts and reconnect delays for sessionID. It must be called before Start; the
+// default policy dials SocketConnectHost/SocketConnectPort and retries with the
+// session's configured exponential backoff.
+func (i *Initiator) SetConnectionAttemptPolicy(sessionID SessionID, policy ConnectionAttemptPolicy) {
+	i.connMu.Lock()
+	defer i.connMu.Unlock()
+
+	i.connPolicies[sessionID] = policy
+}
+
+// connectionAttemptPolicy returns the ConnectionAttemptPolicy configured for sessionID.
+func (i *Initiator) connectionAttemptPolicy(sessionID SessionID) ConnectionAttemptPolicy {
+	i.connMu.RLock()
+	defer i.connMu.RUnlock()
+
+	if policy, ok := i.connPolicies[sessionID]; ok {
+		return policy
+	}
+
+	return defaultConnectionAttemptPolicy{}
+}
+
 // waitForInSessionTime returns true if the session is in session, false if the handler should stop.
 func (i *Initiator) waitForInSessionTime(session *session) bool {
 	inSessionTime := make(chan interface{})
@@ -159,13 +193,19 @@ func (i *Initiator) handleConnection(session *session, tlsConfig *tls.Config, di
 		wg.Wait()
 	}()
 
-	connectionAttempt := 0
+	policy := i.connectionAttemptPolicy(session.sessionID)
+
+	attempt := 0
 
 	for {
+		i.setState(session.sessionID, Idle)
 		if !i.waitForInSessionTime(session) {
+			i.setState(session.sessionID, Shutdown)
 			return
 		}
 
+		i.setState(session.sessionID, Connecting)
+
 		ctx, cancel := context.WithCancel(context.Background())
 
 		// We start a goroutine in order to be able to cancel the dialer mid-connection
@@ -182,23 +222,67 @@ func (i *Initiator) handleConnection(session *session, tlsConfig *tls.Config, di
 		var disconnected chan interface{}
 		var msgIn chan fixIn
 		var msgOut chan []byte
+		var connectedAt time.Time
+		var attemptErr error
+		var addr string
+		var timeout time.Duration
+		var dialCtx context.Context
+		var dialCancel context.CancelFunc
+		var netConn net.Conn
+		var err error
+
+		addr, timeout, err = policy.NextEndpoint(ctx, session, attempt)
+		if err != nil {
+			session.log.OnEventf("Failed to resolve next endpoint: %v", err)
+			i.setState(session.sessionID, TransientFailure)
+			attemptErr = err
+			goto reconnect
+		}
 
-		netConn, err := dialer.Dial(ctx, session, connectionAttempt, tlsConfig)
+		dialCtx = ctx
+		if timeout > 0 {
+			dialCtx, dialCancel = context.WithTimeout(ctx, timeout)
+		}
+
+		if addressable, ok := dialer.(AddressableDialer); ok {
+			netConn, err = addressable.DialAddr(dialCtx, addr, session, attempt, tlsConfig)
+		} else {
+			// dialer can't dial the address the policy resolved; it will fall back to
+			// SocketConnectHost/SocketConnectPort instead. That's a silent policy
+			// override for any policy resolving something else (failover/VIP rotation,
+			// service discovery), so log it loudly rather than dropping it.
+			if defaultAddr := defaultSocketConnectAddr(session); addr != defaultAddr {
+				session.log.OnEventf("ConnectionAttemptPolicy resolved address %v but dialer %T does not implement AddressableDialer; dialing %v instead", addr, dialer, defaultAddr)
+			}
+			netConn, err = dialer.Dial(dialCtx, session, attempt, tlsConfig)
+		}
+		if dialCancel != nil {
+			// Release the per-attempt dial timeout context as soon as the dial
+			// completes instead of deferring, since this runs inside a
+			// reconnect loop that can iterate for the lifetime of the session.
+			dialCancel()
+		}
 		if err != nil {
 			session.log.OnEventf("Failed to connect: %v", err)
+			i.setState(session.sessionID, TransientFailure)
+			attemptErr = err
 			goto reconnect
-		} else {
-			address := netConn.RemoteAddr().String()
-			session.log.OnEventf("connected to remote address: %v", address)
 		}
 
+		session.log.OnEventf("connected to remote address: %v", netConn.RemoteAddr().String())
+		i.setState(session.sessionID, Connected)
+
 		msgIn = make(chan fixIn)
 		msgOut = make(chan []byte)
 		if err := session.connect(msgIn, msgOut); err != nil {
 			session.log.OnEventf("Failed to initiate: %v", err)
+			i.setState(session.sessionID, TransientFailure)
+			attemptErr = err
 			goto reconnect
 		}
 
+		connectedAt = time.Now()
+
 		go readLoop(newParser(bufio.NewReader(netConn)), msgIn, session.log)
 		disconnected = make(chan interface{})
 		go func() {
@@ -215,16 +299,31 @@ func (i *Initiator) handleConnection(session *session, tlsConfig *tls.Config, di
 
 		select {
 		case <-disconnected:
+			i.setState(session.sessionID, TransientFailure)
 		case <-i.stopChan:
+			i.setState(session.sessionID, Shutdown)
 			return
 		}
 
 	reconnect:
 		cancel()
 
-		connectionAttempt++
-		session.log.OnEventf("Reconnecting in %v", session.ReconnectInterval)
-		if !i.waitForReconnectInterval(session.ReconnectInterval) {
+		var connectedFor time.Duration
+		if !connectedAt.IsZero() {
+			connectedFor = time.Since(connectedAt)
+		}
+
+		retry, nextAttempt, delay := policy.OnResult(session, attempt, attemptErr, connectedFor)
+		if !retry {
+			session.log.OnEvent("Connection attempt policy declined to retry")
+			i.setState(session.sessionID, Shutdown)
+			return
+		}
+
+		attempt = nextAttempt
+		session.log.OnEventf("Reconnecting in %v", delay)
+		if !i.waitForReconnectInterval(delay) {
+			i.setState(session.sessionID, Shutdown)
 			return
 		}
 	}
@@ -0,0 +1,139 @@
+// Copyright (c) quickfixengine.org  All rights reserved.
+//
+// This file may be distributed under the terms of the quickfixengine.org
+// license as defined by quickfixengine.org and appearing in the file
+// LICENSE included in the packaging of this file.
+//
+// This file is provided AS IS with NO WARRANTY OF ANY KIND, INCLUDING
+// THE WARRANTY OF DESIGN, MERCHANTABILITY AND FITNESS FOR A
+// PARTICULAR PURPOSE.
+//
+// See http://www.quickfixengine.org/LICENSE for licensing information.
+//
+// Contact ask@quickfixengine.org if any conditions of this licensing
+// are not clear to you.
+
+// Same unresolved github.com/quic-go/quic-go dependency as dialer_quic.go; gated behind
+// the quickfix_quic build tag for the same reason. See the note there.
+//go:build quickfix_quic
+
+package quickfix
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"sync"
+
+	"github.com/quic-go/quic-go"
+)
+
+// QUICListener accepts QUIC connections on a single UDP socket and demultiplexes the one
+// bidirectional stream each initiator opens into a net.Conn per FIX session. It
+// satisfies net.Listener.
+//
+// Nothing in this package constructs a QUICListener: no acceptor code calls
+// NewQUICListener, and no session setting selects QUIC on the accepting side.
+type QUICListener struct {
+	transport *quic.Transport
+	tlsConfig *tls.Config
+	conns     chan net.Conn
+	closeOnce chan interface{}
+
+	mu  sync.Mutex
+	err error // set when acceptConnections exits because listener.Accept failed
+}
+
+// NewQUICListener demultiplexes FIX sessions over a single UDP socket/quic.Transport.
+func NewQUICListener(conn net.PacketConn, tlsConfig *tls.Config) (*QUICListener, error) {
+	l := &QUICListener{
+		transport: &quic.Transport{Conn: conn},
+		tlsConfig: tlsConfig,
+		conns:     make(chan net.Conn),
+		closeOnce: make(chan interface{}),
+	}
+
+	listener, err := l.transport.Listen(tlsConfig, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	go l.acceptConnections(listener.Accept)
+
+	return l, nil
+}
+
+// acceptConnections drives the accept loop through a function value rather than the
+// concrete *quic.Listener so the accept-error path below can be exercised in tests
+// without a real QUIC endpoint.
+func (l *QUICListener) acceptConnections(accept func(context.Context) (*quic.Conn, error)) {
+	for {
+		conn, err := accept(context.Background())
+		if err != nil {
+			// listener.Accept only returns an error once the listener is dead (closed
+			// or the underlying transport failed), so callers blocked in Accept need
+			// to be released with that error rather than left hanging forever.
+			l.closeWithErr(err)
+			return
+		}
+
+		go l.acceptStream(conn)
+	}
+}
+
+// closeWithErr records err (unless the listener is already closed) and unblocks any
+// goroutine waiting in Accept. A nil err is used by the explicit Close() path. Only the
+// first close counts, so the accept error that follows an explicit Close is ignored.
+func (l *QUICListener) closeWithErr(err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	select {
+	case <-l.closeOnce:
+	default:
+		l.err = err
+		close(l.closeOnce)
+	}
+}
+
+func (l *QUICListener) acceptStream(conn *quic.Conn) {
+	stream, err := conn.AcceptStream(context.Background())
+	if err != nil {
+		_ = conn.CloseWithError(0, "failed to accept stream")
+		return
+	}
+
+	select {
+	case l.conns <- &quicStreamConn{conn: conn, stream: stream}:
+	case <-l.closeOnce:
+		_ = conn.CloseWithError(0, "listener closed")
+	}
+}
+
+// Accept returns the net.Conn for the next FIX session to open a stream on this socket.
+// Once the listener is dead, Accept returns the error that killed the accept loop (or
+// net.ErrClosed if Close was called explicitly), per the net.Listener contract.
+func (l *QUICListener) Accept() (net.Conn, error) {
+	select {
+	case c := <-l.conns:
+		return c, nil
+	case <-l.closeOnce:
+		l.mu.Lock()
+		err := l.err
+		l.mu.Unlock()
+		if err != nil {
+			return nil, err
+		}
+		return nil, net.ErrClosed
+	}
+}
+
+func (l *QUICListener) Close() error {
+	l.closeWithErr(nil)
+	return l.transport.Close()
+}
+
+// Addr returns the local address the underlying UDP socket is bound to.
+func (l *QUICListener) Addr() net.Addr {
+	return l.transport.Conn.LocalAddr()
+}
@@ -0,0 +1,183 @@
+// Copyright (c) quickfixengine.org  All rights reserved.
+//
+// This file may be distributed under the terms of the quickfixengine.org
+// license as defined by quickfixengine.org and appearing in the file
+// LICENSE included in the packaging of this file.
+//
+// This file is provided AS IS with NO WARRANTY OF ANY KIND, INCLUDING
+// THE WARRANTY OF DESIGN, MERCHANTABILITY AND FITNESS FOR A
+// PARTICULAR PURPOSE.
+//
+// See http://www.quickfixengine.org/LICENSE for licensing information.
+//
+// Contact ask@quickfixengine.org if any conditions of this licensing
+// are not clear to you.
+
+// Exercises listener_quic.go, so it only builds where that file does; see its build-tag
+// note.
+//go:build quickfix_quic
+
+package quickfix
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	// NOTE: requires github.com/quic-go/quic-go as a go.mod dependency; see the note in
+	// listener_quic.go.
+	"github.com/quic-go/quic-go"
+)
+
+var _ net.Listener = (*QUICListener)(nil)
+
+// generateLoopbackTLSConfig returns a minimal self-signed TLS config for dialing and
+// listening on a loopback QUIC endpoint in tests.
+func generateLoopbackTLSConfig(t *testing.T) *tls.Config {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+
+	template := x509.Certificate{SerialNumber: big.NewInt(1)}
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("create certificate: %v", err)
+	}
+
+	cert := tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+	return &tls.Config{
+		Certificates:       []tls.Certificate{cert},
+		InsecureSkipVerify: true,
+		NextProtos:         []string{"quickfix-test"},
+	}
+}
+
+// TestQUICListenerAcceptReturnsSessionStreamOverLoopback drives NewQUICListener/Accept
+// against a real loopback QUIC endpoint and confirms the stream one session opens comes
+// back out of Accept as a working net.Conn.
+func TestQUICListenerAcceptReturnsSessionStreamOverLoopback(t *testing.T) {
+	udpConn, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatalf("listen udp: %v", err)
+	}
+
+	listener, err := NewQUICListener(udpConn, generateLoopbackTLSConfig(t))
+	if err != nil {
+		t.Fatalf("NewQUICListener: %v", err)
+	}
+	defer listener.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	clientConn, err := quic.DialAddr(ctx, listener.Addr().String(), generateLoopbackTLSConfig(t), nil)
+	if err != nil {
+		t.Fatalf("DialAddr: %v", err)
+	}
+	defer clientConn.CloseWithError(0, "")
+
+	clientStream, err := clientConn.OpenStreamSync(ctx)
+	if err != nil {
+		t.Fatalf("OpenStreamSync: %v", err)
+	}
+
+	want := []byte("8=FIX.4.2|")
+	if _, err := clientStream.Write(want); err != nil {
+		t.Fatalf("client write: %v", err)
+	}
+
+	serverConn, err := listener.Accept()
+	if err != nil {
+		t.Fatalf("Accept: %v", err)
+	}
+	defer serverConn.Close()
+
+	got := make([]byte, len(want))
+	if _, err := serverConn.Read(got); err != nil {
+		t.Fatalf("server read: %v", err)
+	}
+
+	if string(got) != string(want) {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+// TestQUICListenerAcceptReturnsErrorAfterAcceptLoopFails exercises the contract
+// net.Listener callers rely on: once the accept loop dies for a reason other than an
+// explicit Close(), a goroutine blocked in Accept must be released with that error
+// instead of hanging forever.
+func TestQUICListenerAcceptReturnsErrorAfterAcceptLoopFails(t *testing.T) {
+	l := &QUICListener{
+		conns:     make(chan net.Conn),
+		closeOnce: make(chan interface{}),
+	}
+
+	wantErr := errors.New("transport died")
+	go l.acceptConnections(func(context.Context) (*quic.Conn, error) {
+		return nil, wantErr
+	})
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := l.Accept()
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, wantErr) {
+			t.Fatalf("got %v, want %v", err, wantErr)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Accept did not return after the accept loop failed")
+	}
+}
+
+// TestQUICListenerCloseUnblocksAcceptWithErrClosed confirms an explicit Close (no accept
+// error involved) still yields the net.ErrClosed contract callers expect.
+func TestQUICListenerCloseUnblocksAcceptWithErrClosed(t *testing.T) {
+	l := &QUICListener{
+		transport: &quic.Transport{Conn: mustListenUDP(t)},
+		conns:     make(chan net.Conn),
+		closeOnce: make(chan interface{}),
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := l.Accept()
+		done <- err
+	}()
+
+	if err := l.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, net.ErrClosed) {
+			t.Fatalf("got %v, want net.ErrClosed", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Accept did not return after Close")
+	}
+}
+
+func mustListenUDP(t *testing.T) *net.UDPConn {
+	t.Helper()
+	conn, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatalf("listen udp: %v", err)
+	}
+	return conn
+}
@@ -0,0 +1,120 @@
+// Copyright (c) quickfixengine.org  All rights reserved.
+//
+// This file may be distributed under the terms of the quickfixengine.org
+// license as defined by quickfixengine.org and appearing in the file
+// LICENSE included in the packaging of this file.
+//
+// This file is provided AS IS with NO WARRANTY OF ANY KIND, INCLUDING
+// THE WARRANTY OF DESIGN, MERCHANTABILITY AND FITNESS FOR A
+// PARTICULAR PURPOSE.
+//
+// See http://www.quickfixengine.org/LICENSE for licensing information.
+//
+// Contact ask@quickfixengine.org if any conditions of this licensing
+// are not clear to you.
+
+package quickfix
+
+import (
+	"math/rand"
+	"strconv"
+	"time"
+)
+
+// Session settings keys for the initiator's exponential-backoff-with-jitter reconnect
+// policy and per-attempt dial timeout. All are optional; omitting them preserves the
+// historical fixed-ReconnectInterval behavior.
+const (
+	configReconnectBackoffMultiplier = "ReconnectBackoffMultiplier"
+	configReconnectMaxInterval       = "ReconnectMaxInterval"
+	configReconnectJitter            = "ReconnectJitter"
+	configReconnectResetInterval     = "ReconnectResetInterval"
+	configSocketConnectTimeout       = "SocketConnectTimeout"
+)
+
+// retryConfig holds the parsed reconnect-backoff and dial-timeout settings for a single
+// initiator session.
+type retryConfig struct {
+	backoffMultiplier float64
+	maxInterval       time.Duration
+	jitter            float64
+	resetInterval     time.Duration
+	connectTimeout    time.Duration
+}
+
+// loadRetryConfig reads the optional backoff/timeout keys for a session out of its
+// SessionSettings. Missing or unparsable values fall back to nextReconnectInterval's
+// defaults (multiplier 1, no cap, no jitter) so the feature is opt-in per session.
+func loadRetryConfig(s *SessionSettings) retryConfig {
+	var cfg retryConfig
+
+	if v, err := s.Setting(configReconnectBackoffMultiplier); err == nil {
+		if f, ferr := strconv.ParseFloat(v, 64); ferr == nil {
+			cfg.backoffMultiplier = f
+		}
+	}
+
+	if v, err := s.Setting(configReconnectMaxInterval); err == nil {
+		if d, derr := time.ParseDuration(v); derr == nil {
+			cfg.maxInterval = d
+		}
+	}
+
+	if v, err := s.Setting(configReconnectJitter); err == nil {
+		if f, ferr := strconv.ParseFloat(v, 64); ferr == nil {
+			cfg.jitter = f
+		}
+	}
+
+	if v, err := s.Setting(configReconnectResetInterval); err == nil {
+		if d, derr := time.ParseDuration(v); derr == nil {
+			cfg.resetInterval = d
+		}
+	}
+
+	if v, err := s.Setting(configSocketConnectTimeout); err == nil {
+		if d, derr := time.ParseDuration(v); derr == nil {
+			cfg.connectTimeout = d
+		}
+	}
+
+	return cfg
+}
+
+// nextReconnectInterval computes the backoff delay for the given connection attempt as
+// min(baseInterval * multiplier^attempt, maxInterval), perturbed by a uniform random
+// factor in [1-jitter, 1+jitter] to avoid a thundering herd of reconnecting sessions.
+func nextReconnectInterval(baseInterval time.Duration, attempt int, cfg retryConfig) time.Duration {
+	if baseInterval <= 0 {
+		return baseInterval
+	}
+
+	multiplier := cfg.backoffMultiplier
+	if multiplier <= 0 {
+		multiplier = 1
+	}
+
+	delay := float64(baseInterval)
+	for n := 0; n < attempt; n++ {
+		delay *= multiplier
+		if cfg.maxInterval > 0 && delay >= float64(cfg.maxInterval) {
+			delay = float64(cfg.maxInterval)
+			break
+		}
+	}
+
+	if jitter := cfg.jitter; jitter > 0 {
+		delay *= 1 + (rand.Float64()*2-1)*jitter
+		// Jitter can perturb delay back above maxInterval even after the clamp in the
+		// loop above, so re-clamp here to keep ReconnectMaxInterval an actual ceiling.
+		if cfg.maxInterval > 0 && delay > float64(cfg.maxInterval) {
+			delay = float64(cfg.maxInterval)
+		}
+	}
+
+	if delay <= 0 {
+		return 0
+	}
+
+	return time.Duration(delay)
+}
@@ -0,0 +1,86 @@
+// Copyright (c) quickfixengine.org  All rights reserved.
+//
+// This file may be distributed under the terms of the quickfixengine.org
+// license as defined by quickfixengine.org and appearing in the file
+// LICENSE included in the packaging of this file.
+//
+// This file is provided AS IS with NO WARRANTY OF ANY KIND, INCLUDING
+// THE WARRANTY OF DESIGN, MERCHANTABILITY AND FITNESS FOR A
+// PARTICULAR PURPOSE.
+//
+// See http://www.quickfixengine.org/LICENSE for licensing information.
+//
+// Contact ask@quickfixengine.org if any conditions of this licensing
+// are not clear to you.
+
+package quickfix
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNextReconnectIntervalGrowsExponentially(t *testing.T) {
+	cfg := retryConfig{backoffMultiplier: 2}
+	base := time.Second
+
+	for attempt, want := range map[int]time.Duration{
+		0: time.Second,
+		1: 2 * time.Second,
+		2: 4 * time.Second,
+		3: 8 * time.Second,
+	} {
+		if got := nextReconnectInterval(base, attempt, cfg); got != want {
+			t.Errorf("attempt %d: got %v, want %v", attempt, got, want)
+		}
+	}
+}
+
+func TestNextReconnectIntervalCapsAtMax(t *testing.T) {
+	cfg := retryConfig{backoffMultiplier: 2, maxInterval: 5 * time.Second}
+	base := time.Second
+
+	if got := nextReconnectInterval(base, 10, cfg); got != 5*time.Second {
+		t.Errorf("got %v, want capped at %v", got, 5*time.Second)
+	}
+}
+
+func TestNextReconnectIntervalNoMultiplierDefaultsToFixedInterval(t *testing.T) {
+	cfg := retryConfig{}
+	base := time.Second
+
+	if got := nextReconnectInterval(base, 5, cfg); got != base {
+		t.Errorf("got %v, want fixed %v", got, base)
+	}
+}
+
+func TestNextReconnectIntervalJitterStaysWithinBounds(t *testing.T) {
+	cfg := retryConfig{backoffMultiplier: 1, jitter: 0.2}
+	base := time.Second
+	low := time.Duration(float64(base) * 0.8)
+	high := time.Duration(float64(base) * 1.2)
+
+	for n := 0; n < 1000; n++ {
+		got := nextReconnectInterval(base, 0, cfg)
+		if got < low || got > high {
+			t.Fatalf("jittered delay %v out of bounds [%v, %v]", got, low, high)
+		}
+	}
+}
+
+func TestNextReconnectIntervalJitterDoesNotExceedMax(t *testing.T) {
+	cfg := retryConfig{backoffMultiplier: 2, maxInterval: 5 * time.Second, jitter: 0.5}
+	base := time.Second
+
+	for n := 0; n < 1000; n++ {
+		if got := nextReconnectInterval(base, 10, cfg); got > 5*time.Second {
+			t.Fatalf("got %v, want capped at %v even after jitter", got, 5*time.Second)
+		}
+	}
+}
+
+func TestNextReconnectIntervalZeroBaseDisablesBackoff(t *testing.T) {
+	if got := nextReconnectInterval(0, 3, retryConfig{backoffMultiplier: 2}); got != 0 {
+		t.Errorf("got %v, want 0", got)
+	}
+}